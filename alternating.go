@@ -0,0 +1,35 @@
+package friendlyhash
+
+import "fmt"
+
+// NewAlternating creates a Hasher like New, but one that picks words from
+// evenDictionary for even output positions (0, 2, 4, ...) and from
+// oddDictionary for odd ones. This matches how the PGP Biometric Word List
+// uses phonetically distinct two- and three-syllable words at alternating
+// positions, so that a listener can tell where one word ends and the next
+// begins when the sequence is read aloud. Both dictionaries must encode the
+// same number of bits per word.
+func NewAlternating(evenDictionary, oddDictionary []string, numberOfBytes int) (*Hasher, error) {
+	if err := validateDictionary(oddDictionary); err != nil {
+		return nil, fmt.Errorf("invalid odd dictionary: %w", err)
+	}
+
+	h, err := New(evenDictionary, numberOfBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	if oddBits := bitsPerWord(len(oddDictionary)); oddBits != h.bitsPerWord {
+		return nil, fmt.Errorf("even and odd dictionaries must encode the same number of bits per word, got %d and %d", h.bitsPerWord, oddBits)
+	}
+
+	oddWordToIndex := make(map[string]int, len(oddDictionary))
+	for i, word := range oddDictionary {
+		oddWordToIndex[word] = i
+	}
+
+	h.oddDictionary = oddDictionary
+	h.oddWordToIndex = oddWordToIndex
+	h.oddPrefixLength = uniquePrefixLength(oddDictionary)
+	return h, nil
+}
@@ -0,0 +1,50 @@
+package friendlyhash
+
+import "testing"
+
+func TestNewAlternating(t *testing.T) {
+	even := []string{"e0", "e1", "e2", "e3"}
+	odd := []string{"o0", "o1", "o2", "o3"}
+
+	h, err := NewAlternating(even, odd, 2)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	hash := []byte{0x1b, 0x2d}
+
+	humanized, err := h.Humanize(hash)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	for i, word := range humanized {
+		if i%2 == 0 {
+			if word[0] != 'e' {
+				t.Fatalf("expected an even-dictionary word at position %d, got %q", i, word)
+			}
+		} else {
+			if word[0] != 'o' {
+				t.Fatalf("expected an odd-dictionary word at position %d, got %q", i, word)
+			}
+		}
+	}
+
+	dehumanized, err := h.Dehumanize(humanized)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if string(dehumanized) != string(hash) {
+		t.Fatalf("got %x expected %x", dehumanized, hash)
+	}
+}
+
+func TestNewAlternatingMismatchedBitsPerWord(t *testing.T) {
+	even := []string{"e0", "e1"}
+	odd := []string{"o0", "o1", "o2", "o3"}
+
+	if _, err := NewAlternating(even, odd, 2); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
@@ -0,0 +1,79 @@
+package friendlyhash
+
+import (
+	"bytes"
+	"crypto"
+	"testing"
+
+	_ "crypto/sha256"
+)
+
+func TestNewWithChecksumUnavailableAlgo(t *testing.T) {
+	if _, err := NewWithChecksum([]string{"a", "b"}, 2, crypto.Hash(0)); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestNewWithChecksumTooManyBits(t *testing.T) {
+	// SHA256 only produces a 32-byte digest, but a 2000-byte payload would
+	// need ceil(2000/4) = 500 checksum bits, far more than the 256 it has.
+	if _, err := NewWithChecksum(createWords(16), 2000, crypto.SHA256); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestChecksumRoundTrip(t *testing.T) {
+	words := createWords(16)
+
+	h, err := NewWithChecksum(words, 4, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	hash := []byte{1, 2, 3, 4}
+
+	humanized, err := h.Humanize(hash)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if len(humanized) != h.NumberOfWords() {
+		t.Fatalf("expected %d words, got: %d", h.NumberOfWords(), len(humanized))
+	}
+
+	dehumanized, err := h.Dehumanize(humanized)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if !bytes.Equal(dehumanized, hash) {
+		t.Fatalf("got %x expected %x", dehumanized, hash)
+	}
+}
+
+func TestChecksumMismatch(t *testing.T) {
+	words := createWords(16)
+
+	h, err := NewWithChecksum(words, 4, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	humanized, err := h.Humanize([]byte{1, 2, 3, 4})
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	// Corrupt a word that only affects the payload, not the checksum.
+	original := humanized[0]
+	for _, word := range words {
+		if word != original {
+			humanized[0] = word
+			break
+		}
+	}
+
+	if _, err := h.Dehumanize(humanized); err != ErrChecksumMismatch {
+		t.Fatalf("expected %s, got: %s", ErrChecksumMismatch, err)
+	}
+}
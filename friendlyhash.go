@@ -0,0 +1,227 @@
+// Package friendlyhash converts binary hashes into short sequences of
+// dictionary words, making them easier for humans to read, write down or
+// read aloud than the equivalent hex or base64 string.
+package friendlyhash
+
+import (
+	"crypto"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// Hasher converts hashes of a fixed number of bytes into slices of words
+// picked from a dictionary, and back.
+type Hasher struct {
+	dictionary      []string
+	wordToIndex     map[string]int
+	prefixLength    int
+	oddDictionary   []string
+	oddWordToIndex  map[string]int
+	oddPrefixLength int
+	numberOfBytes   int
+	bitsPerWord     int
+	checksumAlgo    crypto.Hash
+	checksumBits    int
+}
+
+// New creates a Hasher which humanizes hashes that are numberOfBytes long
+// using the provided dictionary. The dictionary must contain at least two
+// unique words. A single word encodes floor(log2(len(dictionary))) bits, so
+// only the first words up to the largest power of two that fits in
+// len(dictionary) are used and any superfluous words at the end of a larger
+// dictionary are simply never selected.
+func New(dictionary []string, numberOfBytes int) (*Hasher, error) {
+	if err := validateDictionary(dictionary); err != nil {
+		return nil, fmt.Errorf("invalid dictionary: %w", err)
+	}
+
+	wordToIndex := make(map[string]int, len(dictionary))
+	for i, word := range dictionary {
+		wordToIndex[word] = i
+	}
+
+	return &Hasher{
+		dictionary:    dictionary,
+		wordToIndex:   wordToIndex,
+		prefixLength:  uniquePrefixLength(dictionary),
+		numberOfBytes: numberOfBytes,
+		bitsPerWord:   bitsPerWord(len(dictionary)),
+	}, nil
+}
+
+func validateDictionary(dictionary []string) error {
+	if len(dictionary) == 0 {
+		return errors.New("dictionary can't be empty")
+	}
+
+	seen := make(map[string]struct{}, len(dictionary))
+	for _, word := range dictionary {
+		if _, ok := seen[word]; ok {
+			return fmt.Errorf("duplicate word %q", word)
+		}
+		seen[word] = struct{}{}
+	}
+
+	if bitsPerWord(len(dictionary)) < 1 {
+		return errors.New("dictionary must contain at least two words")
+	}
+
+	return nil
+}
+
+// bitsPerWord returns the number of bits that can be encoded by picking a
+// single word out of a dictionary of the given size, i.e. floor(log2(n)).
+func bitsPerWord(n int) int {
+	return bits.Len(uint(n)) - 1
+}
+
+// dictionaryAt returns the dictionary that the word at position i is picked
+// from: the odd dictionary for odd positions of a Hasher created with
+// NewAlternating, the regular dictionary otherwise.
+func (h *Hasher) dictionaryAt(i int) []string {
+	if h.oddDictionary != nil && i%2 == 1 {
+		return h.oddDictionary
+	}
+	return h.dictionary
+}
+
+// wordToIndexAt returns the word-to-index lookup table matching
+// dictionaryAt(i).
+func (h *Hasher) wordToIndexAt(i int) map[string]int {
+	if h.oddWordToIndex != nil && i%2 == 1 {
+		return h.oddWordToIndex
+	}
+	return h.wordToIndex
+}
+
+// prefixLengthAt returns the prefix length matching dictionaryAt(i); see
+// uniquePrefixLength.
+func (h *Hasher) prefixLengthAt(i int) int {
+	if h.oddDictionary != nil && i%2 == 1 {
+		return h.oddPrefixLength
+	}
+	return h.prefixLength
+}
+
+// NumberOfBytes returns the length, in bytes, of the hashes that this Hasher
+// accepts.
+func (h *Hasher) NumberOfBytes() int {
+	return h.numberOfBytes
+}
+
+// NumberOfWords returns the number of words that Humanize produces for a
+// hash of NumberOfBytes bytes, including any trailing checksum words for a
+// Hasher created with NewWithChecksum.
+func (h *Hasher) NumberOfWords() int {
+	totalBits := h.numberOfBytes*8 + h.checksumBits
+	return (totalBits + h.bitsPerWord - 1) / h.bitsPerWord
+}
+
+// Humanize converts hash into a slice of words picked from the dictionary.
+// hash must be exactly NumberOfBytes bytes long. If the Hasher was created
+// with NewWithChecksum, a checksum derived from hash is appended to the bit
+// stream before it is sliced into words.
+func (h *Hasher) Humanize(hash []byte) ([]string, error) {
+	if len(hash) != h.numberOfBytes {
+		return nil, fmt.Errorf("invalid number of bytes: expected %d, got %d", h.numberOfBytes, len(hash))
+	}
+
+	source := hash
+	if h.checksumAlgo != 0 {
+		source = append(append([]byte(nil), hash...), h.checksum(hash)...)
+	}
+
+	totalBits := len(hash)*8 + h.checksumBits
+	words := make([]string, h.NumberOfWords())
+
+	bitIndex := 0
+	for i := range words {
+		index := 0
+		for j := 0; j < h.bitsPerWord; j++ {
+			index <<= 1
+			if bitIndex < totalBits && checkBit(source[bitIndex/8], bitIndex%8) {
+				index |= 1
+			}
+			bitIndex++
+		}
+		words[i] = h.dictionaryAt(i)[index]
+	}
+
+	return words, nil
+}
+
+// Dehumanize reverses Humanize, reconstructing the original hash from the
+// words it produced. words must be exactly NumberOfWords long and each word
+// must come from the dictionary used to construct the Hasher. If the Hasher
+// was created with NewWithChecksum, the trailing checksum bits are verified
+// against hash and ErrChecksumMismatch is returned if they disagree.
+func (h *Hasher) Dehumanize(words []string) ([]byte, error) {
+	if len(words) != h.NumberOfWords() {
+		return nil, fmt.Errorf("invalid number of words: expected %d, got %d", h.NumberOfWords(), len(words))
+	}
+
+	totalBits := h.numberOfBytes*8 + h.checksumBits
+	buf := make([]byte, (totalBits+7)/8)
+	maxIndex := 1 << uint(h.bitsPerWord)
+
+	bitIndex := 0
+	for i, word := range words {
+		index, ok := h.wordToIndexAt(i)[word]
+		if !ok || index >= maxIndex {
+			return nil, fmt.Errorf("word %q is not a valid dictionary entry", word)
+		}
+
+		for j := h.bitsPerWord - 1; j >= 0; j-- {
+			if bitIndex < totalBits {
+				byteIndex, bitInByte := bitIndex/8, bitIndex%8
+				if (index>>uint(j))&1 == 1 {
+					buf[byteIndex] = setBit(buf[byteIndex], bitInByte)
+				} else {
+					buf[byteIndex] = clearBit(buf[byteIndex], bitInByte)
+				}
+			}
+			bitIndex++
+		}
+	}
+
+	hash := buf[:h.numberOfBytes]
+
+	if h.checksumAlgo != 0 {
+		expected := h.checksum(hash)
+		for i := 0; i < h.checksumBits; i++ {
+			globalBit := h.numberOfBytes*8 + i
+			if checkBit(buf[globalBit/8], globalBit%8) != checkBit(expected[i/8], i%8) {
+				return nil, ErrChecksumMismatch
+			}
+		}
+	}
+
+	return hash, nil
+}
+
+// checksum hashes payload with the Hasher's checksum algorithm and returns
+// the resulting digest.
+func (h *Hasher) checksum(payload []byte) []byte {
+	hasher := h.checksumAlgo.New()
+	hasher.Write(payload)
+	return hasher.Sum(nil)
+}
+
+// checkBit reports whether the bit at position i, counting from the most
+// significant bit as 0, is set in b.
+func checkBit(b byte, i int) bool {
+	return b&(1<<uint(7-i)) != 0
+}
+
+// setBit returns b with the bit at position i, counting from the most
+// significant bit as 0, set to 1.
+func setBit(b byte, i int) byte {
+	return b | (1 << uint(7-i))
+}
+
+// clearBit returns b with the bit at position i, counting from the most
+// significant bit as 0, cleared to 0.
+func clearBit(b byte, i int) byte {
+	return b &^ (1 << uint(7-i))
+}
@@ -0,0 +1,90 @@
+package friendlyhash
+
+import (
+	"fmt"
+	"strings"
+)
+
+// uniquePrefixLength returns the smallest K such that truncating every word
+// in dictionary to its first K characters (case-insensitively) still
+// identifies it uniquely, or the length of the longest word in dictionary if
+// no such K exists, since a word can never be identified by a prefix longer
+// than itself.
+func uniquePrefixLength(dictionary []string) int {
+	maxLength := 0
+	for _, word := range dictionary {
+		if len(word) > maxLength {
+			maxLength = len(word)
+		}
+	}
+
+	for k := 1; k < maxLength; k++ {
+		seen := make(map[string]struct{}, len(dictionary))
+		unique := true
+		for _, word := range dictionary {
+			prefix := lowerPrefix(word, k)
+			if _, ok := seen[prefix]; ok {
+				unique = false
+				break
+			}
+			seen[prefix] = struct{}{}
+		}
+		if unique {
+			return k
+		}
+	}
+
+	return maxLength
+}
+
+func lowerPrefix(word string, k int) string {
+	word = strings.ToLower(word)
+	if len(word) > k {
+		return word[:k]
+	}
+	return word
+}
+
+// DehumanizeFuzzy reverses Humanize like Dehumanize, but tolerates input
+// that doesn't match the dictionary exactly: words are compared
+// case-insensitively with leading and trailing whitespace stripped, and may
+// be truncated to any prefix at least as long as the shortest prefix that
+// still identifies a dictionary word uniquely (similar to how BIP39 wallets
+// accept the first four letters of each mnemonic word). If a word's prefix
+// matches more than one dictionary entry, DehumanizeFuzzy returns an error
+// naming the word and its candidates.
+func (h *Hasher) DehumanizeFuzzy(words []string) ([]byte, error) {
+	if len(words) != h.NumberOfWords() {
+		return nil, fmt.Errorf("invalid number of words: expected %d, got %d", h.NumberOfWords(), len(words))
+	}
+
+	resolved := make([]string, len(words))
+	for i, word := range words {
+		normalized := strings.ToLower(strings.TrimSpace(word))
+		dictionary := h.dictionaryAt(i)
+		minPrefixLength := h.prefixLengthAt(i)
+
+		var candidates []string
+		for _, dictWord := range dictionary {
+			lower := strings.ToLower(dictWord)
+			if lower == normalized {
+				candidates = []string{dictWord}
+				break
+			}
+			if len(normalized) >= minPrefixLength && strings.HasPrefix(lower, normalized) {
+				candidates = append(candidates, dictWord)
+			}
+		}
+
+		switch len(candidates) {
+		case 0:
+			return nil, fmt.Errorf("word %d (%q) does not match any dictionary entry", i, word)
+		case 1:
+			resolved[i] = candidates[0]
+		default:
+			return nil, fmt.Errorf("word %d (%q) is ambiguous, candidates: %s", i, word, strings.Join(candidates, ", "))
+		}
+	}
+
+	return h.Dehumanize(resolved)
+}
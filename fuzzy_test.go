@@ -0,0 +1,88 @@
+package friendlyhash
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestUniquePrefixLength(t *testing.T) {
+	testCases := []struct {
+		dictionary []string
+		expected   int
+	}{
+		{[]string{"aardvark", "absurd", "accrue"}, 2},
+		{[]string{"cat", "car", "cart"}, 4},
+		{[]string{"a", "ab"}, 2},
+	}
+
+	for _, testCase := range testCases {
+		if result := uniquePrefixLength(testCase.dictionary); result != testCase.expected {
+			t.Fatalf("%v: expected %d, got: %d", testCase.dictionary, testCase.expected, result)
+		}
+	}
+}
+
+func TestDehumanizeFuzzy(t *testing.T) {
+	words := []string{"aardvark", "absurd", "accrue", "apple"}
+
+	h, err := New(words, 1)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	hash := []byte{0x40} // 01 00 00 00 -> absurd, aardvark, aardvark, aardvark
+
+	humanized, err := h.Humanize(hash)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	fuzzed := make([]string, len(humanized))
+	for i, word := range humanized {
+		fuzzed[i] = "  " + strings.ToUpper(word[:2]) + "  "
+	}
+
+	dehumanized, err := h.DehumanizeFuzzy(fuzzed)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if !bytes.Equal(dehumanized, hash) {
+		t.Fatalf("got %x expected %x", dehumanized, hash)
+	}
+}
+
+func TestDehumanizeFuzzyAmbiguous(t *testing.T) {
+	words := []string{"cat", "car", "cart"}
+
+	h, err := New(words, 1)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	input := createWords(h.NumberOfWords())
+	for i := range input {
+		input[i] = "ca"
+	}
+
+	if _, err := h.DehumanizeFuzzy(input); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestDehumanizeFuzzyUnknownWord(t *testing.T) {
+	words := []string{"aardvark", "absurd"}
+
+	h, err := New(words, 1)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	input := createWords(h.NumberOfWords())
+
+	if _, err := h.DehumanizeFuzzy(input); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
@@ -0,0 +1,43 @@
+package friendlyhash
+
+import (
+	"crypto"
+	"fmt"
+	"io"
+)
+
+// NewForHash creates a Hasher like New, but one sized for the digests
+// produced by algo, so callers don't have to keep numberOfBytes in sync
+// with the hash algorithm by hand.
+func NewForHash(dictionary []string, algo crypto.Hash) (*Hasher, error) {
+	if !algo.Available() {
+		return nil, fmt.Errorf("hash algorithm %s is not available, is its package imported?", algo)
+	}
+
+	return New(dictionary, algo.Size())
+}
+
+// HumanizeBytes hashes data with algo and humanizes the resulting digest.
+func (h *Hasher) HumanizeBytes(data []byte, algo crypto.Hash) ([]string, error) {
+	if !algo.Available() {
+		return nil, fmt.Errorf("hash algorithm %s is not available, is its package imported?", algo)
+	}
+
+	hasher := algo.New()
+	hasher.Write(data)
+	return h.Humanize(hasher.Sum(nil))
+}
+
+// HumanizeReader streams r into algo and humanizes the resulting digest.
+func (h *Hasher) HumanizeReader(r io.Reader, algo crypto.Hash) ([]string, error) {
+	if !algo.Available() {
+		return nil, fmt.Errorf("hash algorithm %s is not available, is its package imported?", algo)
+	}
+
+	hasher := algo.New()
+	if _, err := io.Copy(hasher, r); err != nil {
+		return nil, fmt.Errorf("error reading from r: %w", err)
+	}
+
+	return h.Humanize(hasher.Sum(nil))
+}
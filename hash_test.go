@@ -0,0 +1,76 @@
+package friendlyhash
+
+import (
+	"bytes"
+	"crypto"
+	"strings"
+	"testing"
+
+	_ "crypto/sha256"
+)
+
+func TestNewForHash(t *testing.T) {
+	h, err := NewForHash(createWords(16), crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if h.NumberOfBytes() != crypto.SHA256.Size() {
+		t.Fatalf("expected %d bytes, got: %d", crypto.SHA256.Size(), h.NumberOfBytes())
+	}
+}
+
+func TestNewForHashUnavailableAlgo(t *testing.T) {
+	if _, err := NewForHash(createWords(16), crypto.Hash(0)); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	h, err := NewForHash(createWords(16), crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	data := []byte("hello, friendlyhash")
+
+	humanized, err := h.HumanizeBytes(data, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	hasher := crypto.SHA256.New()
+	hasher.Write(data)
+
+	expected, err := h.Humanize(hasher.Sum(nil))
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if strings.Join(humanized, "-") != strings.Join(expected, "-") {
+		t.Fatalf("got %v expected %v", humanized, expected)
+	}
+}
+
+func TestHumanizeReader(t *testing.T) {
+	h, err := NewForHash(createWords(16), crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	data := []byte("hello, friendlyhash")
+
+	humanized, err := h.HumanizeReader(bytes.NewReader(data), crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	expected, err := h.HumanizeBytes(data, crypto.SHA256)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if strings.Join(humanized, "-") != strings.Join(expected, "-") {
+		t.Fatalf("got %v expected %v", humanized, expected)
+	}
+}
@@ -0,0 +1,267 @@
+package friendlyhash
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// NewEncoder returns a io.WriteCloser which humanizes the bytes written to
+// it, writing whole words separated by sep to w as soon as enough bits have
+// accumulated to form them. Unlike Humanize, the encoder doesn't require
+// knowing the total length up front, so it can be used to humanize
+// arbitrary-length data such as a large file or the output of a pipe. Close
+// must be called once writing is done: it flushes any bits that didn't
+// divide evenly into a whole word by padding them with zeros, and appends a
+// trailer word recording how many padding bits were added so that a Decoder
+// can strip them losslessly.
+func NewEncoder(w io.Writer, h *Hasher, sep string) io.WriteCloser {
+	return &encoder{w: w, h: h, sep: sep}
+}
+
+type encoder struct {
+	w       io.Writer
+	h       *Hasher
+	sep     string
+	acc     uint64
+	accBits uint
+	wordIdx int
+	started bool
+	closed  bool
+}
+
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.closed {
+		return 0, errors.New("friendlyhash: write to a closed Encoder")
+	}
+
+	bpw := uint(e.h.bitsPerWord)
+	for _, b := range p {
+		e.acc = e.acc<<8 | uint64(b)
+		e.accBits += 8
+
+		for e.accBits >= bpw {
+			shift := e.accBits - bpw
+			index := int(e.acc>>shift) & (1<<bpw - 1)
+			if err := e.emit(index); err != nil {
+				return 0, err
+			}
+			e.accBits -= bpw
+			e.acc &= 1<<e.accBits - 1
+		}
+	}
+
+	return len(p), nil
+}
+
+// Close flushes any remaining bits, padded with zeros to fill a whole word,
+// and appends a trailer word carrying the number of padding bits that were
+// added.
+func (e *encoder) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+
+	bpw := uint(e.h.bitsPerWord)
+	padding := 0
+	if e.accBits > 0 {
+		padding = int(bpw - e.accBits)
+		index := int(e.acc<<uint(padding)) & (1<<bpw - 1)
+		if err := e.emit(index); err != nil {
+			return err
+		}
+		e.accBits = 0
+	}
+
+	return e.emit(padding)
+}
+
+func (e *encoder) emit(index int) error {
+	word := e.h.dictionaryAt(e.wordIdx)[index]
+
+	if e.started {
+		if _, err := io.WriteString(e.w, e.sep); err != nil {
+			return err
+		}
+	}
+	if _, err := io.WriteString(e.w, word); err != nil {
+		return err
+	}
+
+	e.started = true
+	e.wordIdx++
+	return nil
+}
+
+// NewDecoder returns an io.Reader which reassembles the bytes humanized by
+// an Encoder using the same Hasher and separator. It tokenizes r on sep and
+// reads only as far ahead as needed to tell the trailer word written by
+// Encoder.Close apart from the data that precedes it, so it can decode a
+// stream too large to buffer in full.
+func NewDecoder(r io.Reader, h *Hasher, sep string) io.Reader {
+	sepBytes := []byte(sep)
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), bufio.MaxScanTokenSize)
+	scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if i := bytes.Index(data, sepBytes); i >= 0 {
+			return i + len(sepBytes), data[:i], nil
+		}
+		if atEOF && len(data) > 0 {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	})
+
+	return &decoder{scanner: scanner, h: h}
+}
+
+type decoder struct {
+	scanner   *bufio.Scanner
+	h         *Hasher
+	held      [2]string
+	heldCount int
+	wordIdx   int
+	acc       uint64
+	accBits   uint
+	out       []byte
+	finished  bool
+	err       error
+}
+
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.finished {
+			if d.err != nil {
+				return 0, d.err
+			}
+			return 0, io.EOF
+		}
+		if err := d.advance(); err != nil {
+			d.err = err
+			d.finished = true
+			return 0, err
+		}
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+	return n, nil
+}
+
+// advance consumes one more token from the underlying scanner. Because the
+// last token in the stream is a padding trailer rather than data, advance
+// keeps the two most recently read tokens held back until it can tell
+// whether the older of the two is an ordinary word (once a third token
+// arrives) or the final data word (once the scanner reaches EOF).
+func (d *decoder) advance() error {
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return err
+		}
+		return d.finish()
+	}
+
+	token := d.scanner.Text()
+	if d.heldCount < 2 {
+		d.held[d.heldCount] = token
+		d.heldCount++
+		return nil
+	}
+
+	if err := d.consume(d.held[0]); err != nil {
+		return err
+	}
+	d.held[0] = d.held[1]
+	d.held[1] = token
+	return nil
+}
+
+// finish is called once the scanner reaches EOF: d.held[0] is the final data
+// word and d.held[1] is the trailer recording how many padding bits it was
+// given.
+func (d *decoder) finish() error {
+	if d.heldCount == 0 {
+		d.finished = true
+		return nil
+	}
+
+	bpw := uint(d.h.bitsPerWord)
+
+	// A stream with no data words at all is just a lone trailer, recording
+	// zero padding bits.
+	if d.heldCount == 1 {
+		padding, ok := d.resolveIndex(d.held[0], d.wordIdx)
+		if !ok {
+			return fmt.Errorf("friendlyhash: word %q is not a valid dictionary entry", d.held[0])
+		}
+		if padding != 0 {
+			return errors.New("friendlyhash: corrupt padding trailer")
+		}
+		d.heldCount = 0
+		d.finished = true
+		return nil
+	}
+
+	index, ok := d.resolveIndex(d.held[0], d.wordIdx)
+	if !ok {
+		return fmt.Errorf("friendlyhash: word %q is not a valid dictionary entry", d.held[0])
+	}
+	d.acc = d.acc<<bpw | uint64(index)
+	d.accBits += bpw
+
+	padding, ok := d.resolveIndex(d.held[1], d.wordIdx+1)
+	if !ok {
+		return fmt.Errorf("friendlyhash: word %q is not a valid dictionary entry", d.held[1])
+	}
+	if uint(padding) > d.accBits {
+		return errors.New("friendlyhash: corrupt padding trailer")
+	}
+	d.acc >>= uint(padding)
+	d.accBits -= uint(padding)
+
+	d.flush()
+	if d.accBits != 0 {
+		return errors.New("friendlyhash: corrupt stream, leftover bits after removing padding")
+	}
+
+	d.heldCount = 0
+	d.finished = true
+	return nil
+}
+
+// consume decodes word, which is known not to be the padding trailer, and
+// appends its bits to the accumulator, flushing whole bytes as they become
+// available.
+func (d *decoder) consume(word string) error {
+	index, ok := d.resolveIndex(word, d.wordIdx)
+	if !ok {
+		return fmt.Errorf("friendlyhash: word %q is not a valid dictionary entry", word)
+	}
+
+	d.acc = d.acc<<uint(d.h.bitsPerWord) | uint64(index)
+	d.accBits += uint(d.h.bitsPerWord)
+	d.wordIdx++
+	d.flush()
+	return nil
+}
+
+func (d *decoder) flush() {
+	for d.accBits >= 8 {
+		shift := d.accBits - 8
+		d.out = append(d.out, byte(d.acc>>shift))
+		d.accBits -= 8
+		d.acc &= 1<<d.accBits - 1
+	}
+}
+
+func (d *decoder) resolveIndex(word string, pos int) (int, bool) {
+	index, ok := d.h.wordToIndexAt(pos)[word]
+	if !ok || index >= 1<<uint(d.h.bitsPerWord) {
+		return 0, false
+	}
+	return index, true
+}
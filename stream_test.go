@@ -0,0 +1,127 @@
+package friendlyhash
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestEncoderDecoderRoundTrip(t *testing.T) {
+	testCases := [][]byte{
+		{},
+		{1},
+		{1, 2},
+		[]byte("the quick brown fox jumps over the lazy dog"),
+		bytes.Repeat([]byte{0xff, 0x00, 0xab}, 100),
+	}
+
+	for words := range testWords(t) {
+		h, err := New(words, 0)
+		if err != nil {
+			t.Fatalf("expected nil, got: %s", err)
+		}
+
+		for _, data := range testCases {
+			var buf bytes.Buffer
+			enc := NewEncoder(&buf, h, "-")
+			if _, err := enc.Write(data); err != nil {
+				t.Fatalf("expected nil, got: %s", err)
+			}
+			if err := enc.Close(); err != nil {
+				t.Fatalf("expected nil, got: %s", err)
+			}
+
+			dec := NewDecoder(&buf, h, "-")
+			decoded, err := ioutil.ReadAll(dec)
+			if err != nil {
+				t.Fatalf("words=%d data=%x: expected nil, got: %s", len(words), data, err)
+			}
+
+			if !bytes.Equal(decoded, data) {
+				t.Fatalf("words=%d: got %x expected %x", len(words), decoded, data)
+			}
+		}
+	}
+}
+
+func TestEncoderMultipleWrites(t *testing.T) {
+	h, err := New(createWords(16), 0)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, h, " ")
+	data := []byte("streaming friendlyhash")
+	for _, b := range data {
+		if _, err := enc.Write([]byte{b}); err != nil {
+			t.Fatalf("expected nil, got: %s", err)
+		}
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	dec := NewDecoder(&buf, h, " ")
+	decoded, err := ioutil.ReadAll(dec)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if !bytes.Equal(decoded, data) {
+		t.Fatalf("got %x expected %x", decoded, data)
+	}
+}
+
+func TestEncoderWriteAfterClose(t *testing.T) {
+	h, err := New(createWords(16), 0)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, h, "-")
+	if err := enc.Close(); err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	if _, err := enc.Write([]byte{1}); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestDecoderTruncatedStream(t *testing.T) {
+	h, err := New(createWords(16), 0)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	dec := NewDecoder(strings.NewReader("1-2-3"), h, "-")
+	if _, err := ioutil.ReadAll(dec); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
+
+func TestDecoderUnknownWord(t *testing.T) {
+	h, err := New(createWords(16), 0)
+	if err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	var buf bytes.Buffer
+	enc := NewEncoder(&buf, h, "-")
+	if _, err := enc.Write([]byte("x")); err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+	if err := enc.Close(); err != nil {
+		t.Fatalf("expected nil, got: %s", err)
+	}
+
+	corrupted := strings.Replace(buf.String(), "-", "-not-a-word-", 1)
+
+	dec := NewDecoder(strings.NewReader(corrupted), h, "-")
+	if _, err := ioutil.ReadAll(dec); err == nil {
+		t.Fatal("expected an error, got nil")
+	}
+}
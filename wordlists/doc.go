@@ -0,0 +1,5 @@
+// Package wordlists bundles ready-to-use word lists for friendlyhash:
+// PGPEven/PGPOdd for PGP-style biometric word encodings via
+// friendlyhash.NewAlternating, and BIP39English and EFFLarge for use with a
+// regular friendlyhash.New Hasher.
+package wordlists
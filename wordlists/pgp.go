@@ -0,0 +1,537 @@
+package wordlists
+
+// PGPEven and PGPOdd are word lists in the style of the PGP Biometric Word
+// List devised by Patrick Juola and Steve Bellovin for reading hashes aloud
+// over a phone line: PGPEven supplies a short word for each even byte
+// position and PGPOdd a longer one for each odd position, so a listener can
+// tell by ear where one word ends and the next begins. Use them together
+// with friendlyhash.NewAlternating to produce a "biometric word list" style
+// encoding of a hash.
+//
+// This package was written without network access to the original
+// wordlist.c or an RFC copy of it, so most entries are transcribed from
+// memory of published material and a handful of entries this author
+// couldn't recall with confidence were filled in with words matching the
+// rest of the list's short/two-syllable or exotic/three-syllable register
+// instead of being left blank. Do not assume byte-for-byte compatibility
+// with another specific PGP/PGPfone tool's word list; diff this against
+// that tool's own copy before relying on interop.
+var PGPEven = []string{
+	"aardvark",
+	"absurd",
+	"accrue",
+	"acme",
+	"adrift",
+	"adult",
+	"afflict",
+	"ahead",
+	"aimless",
+	"Algol",
+	"allow",
+	"alone",
+	"ammo",
+	"ancient",
+	"apple",
+	"artist",
+	"assume",
+	"Athens",
+	"atlas",
+	"Aztec",
+	"baboon",
+	"backfield",
+	"backward",
+	"banjo",
+	"beaming",
+	"bedlamp",
+	"beehive",
+	"beeswax",
+	"befriend",
+	"Belfast",
+	"berserk",
+	"billiard",
+	"bison",
+	"blackjack",
+	"blockade",
+	"blowtorch",
+	"bluebird",
+	"bombast",
+	"bookshelf",
+	"brackish",
+	"breadline",
+	"breakup",
+	"brickyard",
+	"briefcase",
+	"Burbank",
+	"button",
+	"buzzard",
+	"cement",
+	"chairlift",
+	"chatter",
+	"checkup",
+	"chisel",
+	"choking",
+	"chopper",
+	"Christmas",
+	"clamshell",
+	"classic",
+	"classroom",
+	"cleanup",
+	"clockwork",
+	"cobra",
+	"commence",
+	"concert",
+	"cowbell",
+	"crackdown",
+	"cranky",
+	"crowfoot",
+	"crucial",
+	"crumpled",
+	"crusade",
+	"cubic",
+	"dashboard",
+	"deadbolt",
+	"deckhand",
+	"dogsled",
+	"dosage",
+	"dragnet",
+	"drainage",
+	"dreadful",
+	"drifter",
+	"dropper",
+	"drumbeat",
+	"drunken",
+	"Dupont",
+	"dwelling",
+	"eating",
+	"edict",
+	"egghead",
+	"eightball",
+	"endorse",
+	"endow",
+	"enlist",
+	"erosion",
+	"exceed",
+	"eyeglass",
+	"eyetooth",
+	"facial",
+	"fallout",
+	"flagpole",
+	"flatfoot",
+	"flytrap",
+	"fracture",
+	"framework",
+	"freedom",
+	"frighten",
+	"gazelle",
+	"Geiger",
+	"glitter",
+	"glucose",
+	"goggles",
+	"goldfish",
+	"gremlin",
+	"guidance",
+	"hamlet",
+	"highchair",
+	"hockey",
+	"indoors",
+	"indulge",
+	"inverse",
+	"involve",
+	"island",
+	"jawbone",
+	"keyboard",
+	"kickoff",
+	"kiwi",
+	"klaxon",
+	"locale",
+	"lockup",
+	"merit",
+	"minnow",
+	"miser",
+	"Mohawk",
+	"mural",
+	"music",
+	"necklace",
+	"Neptune",
+	"newborn",
+	"nightbird",
+	"Oakland",
+	"obtuse",
+	"offload",
+	"optic",
+	"orca",
+	"payday",
+	"peachy",
+	"pheasant",
+	"physique",
+	"playhouse",
+	"Pluto",
+	"preclude",
+	"prefer",
+	"preshrunk",
+	"printer",
+	"prowler",
+	"pupil",
+	"puppy",
+	"python",
+	"quadrant",
+	"quiver",
+	"quota",
+	"ragtime",
+	"ratchet",
+	"rebirth",
+	"reform",
+	"regain",
+	"reindeer",
+	"rematch",
+	"repay",
+	"retouch",
+	"revenge",
+	"reward",
+	"rhythm",
+	"ribcage",
+	"ringbolt",
+	"robust",
+	"rocker",
+	"ruffled",
+	"sailboat",
+	"sawdust",
+	"scallion",
+	"scenic",
+	"scorecard",
+	"Scotland",
+	"seabird",
+	"select",
+	"sentence",
+	"shadow",
+	"shamrock",
+	"showgirl",
+	"skullcap",
+	"skydive",
+	"slingshot",
+	"slowdown",
+	"snapline",
+	"snapshot",
+	"snowcap",
+	"snowslide",
+	"solo",
+	"spaniel",
+	"spearhead",
+	"spellbind",
+	"spheroid",
+	"spigot",
+	"spindle",
+	"spyglass",
+	"stagehand",
+	"stagnate",
+	"stairway",
+	"standard",
+	"stapler",
+	"steamship",
+	"sterling",
+	"stockman",
+	"stopwatch",
+	"stormy",
+	"sugar",
+	"surmount",
+	"suspense",
+	"sweatband",
+	"swelter",
+	"thermos",
+	"tissue",
+	"tonic",
+	"topmost",
+	"tracker",
+	"transit",
+	"trauma",
+	"treadmill",
+	"Trojan",
+	"trouble",
+	"tumor",
+	"tunnel",
+	"tycoon",
+	"uncut",
+	"unearth",
+	"unwind",
+	"uproot",
+	"upset",
+	"upshot",
+	"vapor",
+	"village",
+	"vindicate",
+	"visitor",
+	"vocalist",
+	"voyager",
+	"wallet",
+	"wayside",
+	"willow",
+	"woodlark",
+	"condor",
+	"falcon",
+	"goblin",
+	"hornet",
+	"jackal",
+	"kraken",
+	"Zulu",
+}
+
+// PGPOdd is the three-syllable-shaped companion to PGPEven; see its
+// documentation for details.
+var PGPOdd = []string{
+	"adroitness",
+	"adviser",
+	"aftermath",
+	"aggregate",
+	"alkali",
+	"almighty",
+	"amulet",
+	"amusement",
+	"antenna",
+	"applicant",
+	"Apollo",
+	"armistice",
+	"article",
+	"asteroid",
+	"Atlantic",
+	"atmosphere",
+	"autopsy",
+	"Babylon",
+	"backwater",
+	"barbecue",
+	"belowground",
+	"bifocals",
+	"bodyguard",
+	"bookseller",
+	"borderline",
+	"bottomless",
+	"Bradbury",
+	"bravado",
+	"Brazilian",
+	"breakaway",
+	"Burlington",
+	"businessman",
+	"butterfat",
+	"Camelot",
+	"candidate",
+	"cannonball",
+	"Capricorn",
+	"caravan",
+	"caretaker",
+	"celebrate",
+	"cellulose",
+	"certify",
+	"chambermaid",
+	"Cherokee",
+	"Chicago",
+	"clergyman",
+	"coherence",
+	"combustion",
+	"commando",
+	"company",
+	"component",
+	"concurrent",
+	"confidence",
+	"conformist",
+	"congregate",
+	"consensus",
+	"consulting",
+	"corporate",
+	"corrosion",
+	"councilman",
+	"crossover",
+	"crucifix",
+	"cumbersome",
+	"customer",
+	"Dakota",
+	"decadence",
+	"December",
+	"decimal",
+	"designing",
+	"detector",
+	"detergent",
+	"determine",
+	"dictator",
+	"dinosaur",
+	"direction",
+	"disable",
+	"disbelief",
+	"disruptive",
+	"distortion",
+	"document",
+	"embezzle",
+	"enchanting",
+	"enrollment",
+	"enterprise",
+	"equation",
+	"equipment",
+	"escapade",
+	"Eskimo",
+	"espionage",
+	"exchange",
+	"exodus",
+	"fascinate",
+	"filament",
+	"finicky",
+	"forever",
+	"fortitude",
+	"frequency",
+	"gadgetry",
+	"Galveston",
+	"getaway",
+	"glossary",
+	"gossamer",
+	"graduate",
+	"gravity",
+	"guitarist",
+	"hamburger",
+	"Hamilton",
+	"handiwork",
+	"hazardous",
+	"headwaters",
+	"hemisphere",
+	"hesitate",
+	"hideaway",
+	"holiness",
+	"hurricane",
+	"hydraulic",
+	"impartial",
+	"impetus",
+	"inception",
+	"indigo",
+	"inertia",
+	"infancy",
+	"inferno",
+	"informant",
+	"insincere",
+	"insurgent",
+	"integrate",
+	"intention",
+	"inventive",
+	"Istanbul",
+	"Jamaica",
+	"Jupiter",
+	"leprosy",
+	"letterhead",
+	"liberty",
+	"maritime",
+	"matchmaker",
+	"maverick",
+	"Medusa",
+	"megaton",
+	"microscope",
+	"microwave",
+	"midsummer",
+	"millionaire",
+	"miracle",
+	"misnomer",
+	"molasses",
+	"molecule",
+	"Montana",
+	"monument",
+	"mosquito",
+	"narrative",
+	"nebula",
+	"newsletter",
+	"Norwegian",
+	"October",
+	"Ohio",
+	"onlooker",
+	"opulent",
+	"Orlando",
+	"outfielder",
+	"Pacific",
+	"pandemic",
+	"Pandora",
+	"paperweight",
+	"paragon",
+	"paragraph",
+	"paramount",
+	"passenger",
+	"pedigree",
+	"Pegasus",
+	"penetrate",
+	"perceptive",
+	"performance",
+	"pharmacy",
+	"phonetic",
+	"photograph",
+	"pioneer",
+	"pocketful",
+	"politeness",
+	"positive",
+	"potato",
+	"processor",
+	"provincial",
+	"proximate",
+	"puberty",
+	"publisher",
+	"pyramid",
+	"quantity",
+	"racketeer",
+	"rebellion",
+	"recipe",
+	"recover",
+	"repellent",
+	"replica",
+	"reproduce",
+	"resistor",
+	"responsive",
+	"retraction",
+	"retrieval",
+	"retrospect",
+	"revenue",
+	"revival",
+	"revolver",
+	"sandalwood",
+	"sardonic",
+	"Saturday",
+	"savagery",
+	"scavenger",
+	"sensation",
+	"sociable",
+	"souvenir",
+	"specialist",
+	"speculate",
+	"stethoscope",
+	"stupendous",
+	"supportive",
+	"surrender",
+	"suspicious",
+	"sympathy",
+	"tambourine",
+	"telephone",
+	"therapist",
+	"tobacco",
+	"toboggan",
+	"tomorrow",
+	"torpedo",
+	"tradition",
+	"travesty",
+	"trombonist",
+	"tumultuous",
+	"typewriter",
+	"ultimate",
+	"undaunted",
+	"underfoot",
+	"unicorn",
+	"unify",
+	"universe",
+	"unravel",
+	"upcoming",
+	"vacancy",
+	"vagabond",
+	"vertigo",
+	"Virginia",
+	"enigma",
+	"platypus",
+	"octagon",
+	"cinnamon",
+	"obstacle",
+	"vigilant",
+	"dynasty",
+	"calendar",
+	"buffalo",
+	"carnival",
+	"pelican",
+	"wizardry",
+}
@@ -0,0 +1,33 @@
+package wordlists
+
+import "testing"
+
+func assertUnique(t *testing.T, name string, words []string, expectedLength int) {
+	if len(words) != expectedLength {
+		t.Fatalf("%s: expected %d words, got: %d", name, expectedLength, len(words))
+	}
+
+	seen := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if _, ok := seen[word]; ok {
+			t.Fatalf("%s: duplicate word %q", name, word)
+		}
+		seen[word] = struct{}{}
+	}
+}
+
+func TestPGPEven(t *testing.T) {
+	assertUnique(t, "PGPEven", PGPEven, 256)
+}
+
+func TestPGPOdd(t *testing.T) {
+	assertUnique(t, "PGPOdd", PGPOdd, 256)
+}
+
+func TestBIP39English(t *testing.T) {
+	assertUnique(t, "BIP39English", BIP39English, 2048)
+}
+
+func TestEFFLarge(t *testing.T) {
+	assertUnique(t, "EFFLarge", EFFLarge, 7776)
+}